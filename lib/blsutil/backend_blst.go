@@ -0,0 +1,78 @@
+//go:build blst
+
+package blsutil
+
+import (
+	blst "github.com/supranational/blst/bindings/go"
+
+	"github.com/piplabs/story/lib/errors"
+)
+
+// dst is the domain separation tag for min-pk BLS12-381 signatures, matching the
+// ciphersuite used elsewhere for vote extension / attestation signing.
+const dst = "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"
+
+func backendPrivKeyToPub(key PrivKey) (PubKey, error) {
+	var sk blst.SecretKey
+	sk.Deserialize(key)
+
+	return new(blst.P1Affine).From(&sk).Compress(), nil
+}
+
+func backendSign(key PrivKey, msg []byte) (Signature, error) {
+	var sk blst.SecretKey
+	sk.Deserialize(key)
+
+	sig := new(blst.P2Affine).Sign(&sk, msg, []byte(dst))
+
+	return sig.Compress(), nil
+}
+
+func backendVerify(pubkey PubKey, msg []byte, sig Signature) (bool, error) {
+	pk := new(blst.P1Affine).Uncompress(pubkey)
+	if pk == nil {
+		return false, errors.New("invalid public key")
+	}
+
+	s := new(blst.P2Affine).Uncompress(sig)
+	if s == nil {
+		return false, errors.New("invalid signature")
+	}
+
+	return s.Verify(true, pk, true, msg, []byte(dst)), nil
+}
+
+func backendAggregate(sigs []Signature) (Signature, error) {
+	var agg blst.P2Aggregate
+	for _, sig := range sigs {
+		s := new(blst.P2Affine).Uncompress(sig)
+		if s == nil {
+			return nil, errors.New("invalid signature")
+		}
+
+		if !agg.Add(s, true) {
+			return nil, errors.New("aggregate signature")
+		}
+	}
+
+	return agg.ToAffine().Compress(), nil
+}
+
+func backendVerifyAggregate(pubkeys []PubKey, msgs [][]byte, aggSig Signature) (bool, error) {
+	pks := make([]*blst.P1Affine, 0, len(pubkeys))
+	for _, pubkey := range pubkeys {
+		pk := new(blst.P1Affine).Uncompress(pubkey)
+		if pk == nil {
+			return false, errors.New("invalid public key")
+		}
+
+		pks = append(pks, pk)
+	}
+
+	s := new(blst.P2Affine).Uncompress(aggSig)
+	if s == nil {
+		return false, errors.New("invalid signature")
+	}
+
+	return s.AggregateVerify(true, pks, true, msgs, []byte(dst)), nil
+}
@@ -0,0 +1,109 @@
+//go:build !blst
+
+// This default (!blst) backend avoids the CGO-heavy blst bindings so that
+// pure-Go builds (e.g. cross-compilation, some CI targets) keep compiling.
+// Enable the faster blst backend with `-tags blst` where CGO is available.
+package blsutil
+
+import (
+	bls12381 "github.com/kilic/bls12-381"
+
+	"github.com/piplabs/story/lib/errors"
+)
+
+var domain = bls12381.NewDomain([]byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"))
+
+func backendPrivKeyToPub(key PrivKey) (PubKey, error) {
+	sk := bls12381.NewFr().FromBytes(key)
+
+	g1 := bls12381.NewG1()
+	pub := g1.New()
+	g1.MulScalar(pub, g1.One(), sk)
+
+	return g1.ToCompressed(pub), nil
+}
+
+func backendSign(key PrivKey, msg []byte) (Signature, error) {
+	sk := bls12381.NewFr().FromBytes(key)
+
+	point, err := domain.HashToCurveG2(msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "hash to curve")
+	}
+
+	g2 := bls12381.NewG2()
+	sig := g2.New()
+	g2.MulScalar(sig, point, sk)
+
+	return g2.ToCompressed(sig), nil
+}
+
+func backendVerify(pubkey PubKey, msg []byte, sig Signature) (bool, error) {
+	g1 := bls12381.NewG1()
+	pk, err := g1.FromCompressed(pubkey)
+	if err != nil {
+		return false, errors.Wrap(err, "decompress public key")
+	}
+
+	g2 := bls12381.NewG2()
+	s, err := g2.FromCompressed(sig)
+	if err != nil {
+		return false, errors.Wrap(err, "decompress signature")
+	}
+
+	point, err := domain.HashToCurveG2(msg)
+	if err != nil {
+		return false, errors.Wrap(err, "hash to curve")
+	}
+
+	eng := bls12381.NewEngine()
+	eng.AddPair(pk, point)
+	eng.AddPairInv(g1.One(), s)
+
+	return eng.Check(), nil
+}
+
+func backendAggregate(sigs []Signature) (Signature, error) {
+	g2 := bls12381.NewG2()
+	agg := g2.Zero()
+
+	for _, sig := range sigs {
+		s, err := g2.FromCompressed(sig)
+		if err != nil {
+			return nil, errors.Wrap(err, "decompress signature")
+		}
+
+		g2.Add(agg, agg, s)
+	}
+
+	return g2.ToCompressed(agg), nil
+}
+
+func backendVerifyAggregate(pubkeys []PubKey, msgs [][]byte, aggSig Signature) (bool, error) {
+	g1 := bls12381.NewG1()
+	g2 := bls12381.NewG2()
+
+	s, err := g2.FromCompressed(aggSig)
+	if err != nil {
+		return false, errors.Wrap(err, "decompress signature")
+	}
+
+	eng := bls12381.NewEngine()
+	eng.AddPair(g1.One(), s)
+
+	for i, pubkey := range pubkeys {
+		pk, err := g1.FromCompressed(pubkey)
+		if err != nil {
+			return false, errors.Wrap(err, "decompress public key")
+		}
+
+		point, err := domain.HashToCurveG2(msgs[i])
+		if err != nil {
+			return false, errors.Wrap(err, "hash to curve")
+		}
+
+		eng.AddPairInv(pk, point)
+	}
+
+	return eng.Check(), nil
+}
@@ -0,0 +1,117 @@
+// Package blsutil provides functions to sign and verify BLS12-381 (min-pk)
+// signatures, and to aggregate them, mirroring the lib/k1util API.
+package blsutil
+
+import (
+	"crypto/rand"
+
+	cryptopb "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	"github.com/piplabs/story/lib/errors"
+)
+
+// privkeyLen is the length of a BLS12-381 scalar private key.
+const privkeyLen = 32
+
+// pubkeyCompressedLen is the length of a compressed BLS12-381 G1 public key.
+const pubkeyCompressedLen = 48
+
+// signatureLen is the length of a compressed BLS12-381 G2 signature.
+const signatureLen = 96
+
+// PrivKey is a BLS12-381 private key.
+type PrivKey []byte
+
+// PubKey is a compressed BLS12-381 public key.
+type PubKey []byte
+
+// Signature is a compressed BLS12-381 signature.
+type Signature []byte
+
+// GenPrivKey returns a random BLS12-381 private key.
+func GenPrivKey() (PrivKey, error) {
+	key := make(PrivKey, privkeyLen)
+	if _, err := rand.Read(key); err != nil {
+		return nil, errors.Wrap(err, "generate private key")
+	}
+
+	return key, nil
+}
+
+// PrivKeyToPub returns the public key corresponding to key.
+func PrivKeyToPub(key PrivKey) (PubKey, error) {
+	if len(key) != privkeyLen {
+		return nil, errors.New("invalid private key length", "length", len(key))
+	}
+
+	return backendPrivKeyToPub(key)
+}
+
+// Sign returns a BLS12-381 signature over msg using the given private key.
+func Sign(key PrivKey, msg []byte) (Signature, error) {
+	return backendSign(key, msg)
+}
+
+// Verify returns whether sig is a valid BLS12-381 signature over msg for pubkey.
+func Verify(pubkey PubKey, msg []byte, sig Signature) (bool, error) {
+	if len(pubkey) != pubkeyCompressedLen {
+		return false, errors.New("invalid pubkey length", "length", len(pubkey))
+	}
+	if len(sig) != signatureLen {
+		return false, errors.New("invalid signature length", "length", len(sig))
+	}
+
+	return backendVerify(pubkey, msg, sig)
+}
+
+// AggregateSignatures combines multiple BLS12-381 signatures into a single
+// signature. The result verifies against the corresponding set of (pubkey, msg)
+// pairs via VerifyAggregate.
+func AggregateSignatures(sigs []Signature) (Signature, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures to aggregate")
+	}
+
+	for _, sig := range sigs {
+		if len(sig) != signatureLen {
+			return nil, errors.New("invalid signature length", "length", len(sig))
+		}
+	}
+
+	return backendAggregate(sigs)
+}
+
+// VerifyAggregate returns whether aggSig is a valid aggregate signature of each
+// pubkeys[i] over msgs[i].
+func VerifyAggregate(pubkeys []PubKey, msgs [][]byte, aggSig Signature) (bool, error) {
+	if len(pubkeys) == 0 || len(pubkeys) != len(msgs) {
+		return false, errors.New("pubkeys and msgs must be equal length and non-empty",
+			"pubkeys", len(pubkeys), "msgs", len(msgs))
+	}
+	if len(aggSig) != signatureLen {
+		return false, errors.New("invalid signature length", "length", len(aggSig))
+	}
+
+	for _, pubkey := range pubkeys {
+		if len(pubkey) != pubkeyCompressedLen {
+			return false, errors.New("invalid pubkey length", "length", len(pubkey))
+		}
+	}
+
+	return backendVerifyAggregate(pubkeys, msgs, aggSig)
+}
+
+// PubKeyToBytes returns the compressed byte representation of pubkey.
+func PubKeyToBytes(pubkey PubKey) []byte {
+	return pubkey
+}
+
+// PBPubKeyFromBytes wraps the given compressed BLS12-381 public key bytes into
+// the cometbft protobuf PublicKey type, mirroring k1util.PBPubKeyFromBytes.
+func PBPubKeyFromBytes(pubkey []byte) (cryptopb.PublicKey, error) {
+	if len(pubkey) != pubkeyCompressedLen {
+		return cryptopb.PublicKey{}, errors.New("invalid pubkey length", "length", len(pubkey))
+	}
+
+	return cryptopb.PublicKey{Sum: &cryptopb.PublicKey_Bls12_381{Bls12_381: pubkey}}, nil
+}
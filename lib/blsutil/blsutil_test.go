@@ -0,0 +1,97 @@
+package blsutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/piplabs/story/lib/blsutil"
+)
+
+func TestSignVerify(t *testing.T) {
+	t.Parallel()
+
+	key, err := blsutil.GenPrivKey()
+	require.NoError(t, err)
+
+	pubkey, err := blsutil.PrivKeyToPub(key)
+	require.NoError(t, err)
+
+	msg := []byte("hello story")
+
+	sig, err := blsutil.Sign(key, msg)
+	require.NoError(t, err)
+
+	ok, err := blsutil.Verify(pubkey, msg, sig)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = blsutil.Verify(pubkey, []byte("other message"), sig)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestAggregateVerify(t *testing.T) {
+	t.Parallel()
+
+	const n = 3
+
+	pubkeys := make([]blsutil.PubKey, n)
+	msgs := make([][]byte, n)
+	sigs := make([]blsutil.Signature, n)
+
+	for i := range n {
+		key, err := blsutil.GenPrivKey()
+		require.NoError(t, err)
+
+		pubkey, err := blsutil.PrivKeyToPub(key)
+		require.NoError(t, err)
+
+		msg := []byte{byte(i), byte(i), byte(i)}
+
+		sig, err := blsutil.Sign(key, msg)
+		require.NoError(t, err)
+
+		pubkeys[i] = pubkey
+		msgs[i] = msg
+		sigs[i] = sig
+	}
+
+	aggSig, err := blsutil.AggregateSignatures(sigs)
+	require.NoError(t, err)
+
+	ok, err := blsutil.VerifyAggregate(pubkeys, msgs, aggSig)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestPBPubKeyFromBytes(t *testing.T) {
+	t.Parallel()
+
+	key, err := blsutil.GenPrivKey()
+	require.NoError(t, err)
+
+	pubkey, err := blsutil.PrivKeyToPub(key)
+	require.NoError(t, err)
+
+	pb, err := blsutil.PBPubKeyFromBytes(pubkey)
+	require.NoError(t, err)
+	require.Equal(t, []byte(pubkey), pb.GetBls12_381())
+}
+
+// TestPubKeyBytesToCosmos_DefaultBuildUnavailable documents and enforces
+// that the default (!bls12381) build can't produce a real cosmos-sdk
+// BLS12-381 pubkey, since that cosmos-sdk package itself requires the
+// "bls12381" tag; see cosmos_default.go.
+func TestPubKeyBytesToCosmos_DefaultBuildUnavailable(t *testing.T) {
+	t.Parallel()
+
+	key, err := blsutil.GenPrivKey()
+	require.NoError(t, err)
+
+	pubkey, err := blsutil.PrivKeyToPub(key)
+	require.NoError(t, err)
+
+	_, err = blsutil.PubKeyBytesToCosmos(pubkey)
+	require.Error(t, err)
+}
@@ -0,0 +1,26 @@
+//go:build bls12381
+
+package blsutil
+
+import (
+	cosmosbls12381 "github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	cosmoscrypto "github.com/cosmos/cosmos-sdk/crypto/types"
+
+	"github.com/piplabs/story/lib/errors"
+)
+
+// PubKeyBytesToCosmos wraps the given compressed BLS12-381 public key bytes as
+// a cosmos-sdk BLS12-381 pubkey, for the same codec paths that
+// k1util.PubKeyBytesToCosmos feeds for secp256k1.
+//
+// cosmos-sdk gates crypto/keys/bls12_381 behind the "bls12381" build tag
+// (it depends on the CGO blst bindings), so this file carries the same tag:
+// importing it unconditionally would break blsutil's own default (!blst)
+// build. Build with `-tags bls12381` to link this in.
+func PubKeyBytesToCosmos(pubkey []byte) (cosmoscrypto.PubKey, error) {
+	if len(pubkey) != pubkeyCompressedLen {
+		return nil, errors.New("invalid pubkey length", "length", len(pubkey))
+	}
+
+	return &cosmosbls12381.PubKey{Key: pubkey}, nil
+}
@@ -0,0 +1,24 @@
+//go:build !bls12381
+
+package blsutil
+
+import (
+	cosmoscrypto "github.com/cosmos/cosmos-sdk/crypto/types"
+
+	"github.com/piplabs/story/lib/errors"
+)
+
+// errCosmosTypeUnavailable is returned by PubKeyBytesToCosmos when built
+// without the "bls12381" tag.
+var errCosmosTypeUnavailable = errors.New(
+	"cosmos-sdk bls12-381 pubkey type requires building with -tags bls12381")
+
+// PubKeyBytesToCosmos would wrap pubkey as a cosmos-sdk BLS12-381 pubkey, but
+// cosmos-sdk's crypto/keys/bls12_381 package is itself only buildable with
+// the "bls12381" tag (it depends on the CGO blst bindings), so this default
+// build can't import it without breaking blsutil's own default (!blst) build
+// promise of staying pure-Go. Build with `-tags bls12381` to get the real
+// implementation in cosmos_bls12381.go.
+func PubKeyBytesToCosmos(_ []byte) (cosmoscrypto.PubKey, error) {
+	return nil, errCosmosTypeUnavailable
+}
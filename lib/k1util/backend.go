@@ -0,0 +1,36 @@
+package k1util
+
+import (
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/piplabs/story/lib/cast"
+	"github.com/piplabs/story/lib/errors"
+)
+
+// backend abstracts the secp256k1 sign/recover primitives, so a CGO-accelerated
+// implementation and a pure-Go implementation can be swapped in via the
+// "purego" build tag without changing any caller.
+type backend interface {
+	// sign returns a 65 byte [R || S || V] signature over hash using privkey.
+	sign(privkey *secp256k1.PrivateKey, hash [32]byte) ([65]byte, error)
+	// recover returns the public key that produced sig over hash.
+	recover(hash [32]byte, sig [65]byte) (*secp256k1.PublicKey, error)
+	// address returns the Ethereum address for pubkey. The pure-Go backend
+	// implements this without go-ethereum/crypto, so that package stays out of
+	// the purego build's hot Verify path.
+	address(pubkey *secp256k1.PublicKey) common.Address
+}
+
+// errInvalidRecoveryID is returned when a signature's V byte isn't 27 or 28.
+var errInvalidRecoveryID = errors.New("invalid recovery id (V) format, must be 27 or 28")
+
+// signCompact signs hash with privkey and converts the result from "compact"
+// into "Ethereum R S V" format. Both backends sign identically; only recovery
+// and address derivation differ.
+func signCompact(privkey *secp256k1.PrivateKey, hash [32]byte) ([65]byte, error) {
+	sig := ecdsa.SignCompact(privkey, hash[:], false)
+
+	return cast.Array65(append(sig[1:], sig[0]))
+}
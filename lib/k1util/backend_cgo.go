@@ -0,0 +1,42 @@
+//go:build !purego
+
+package k1util
+
+import (
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/piplabs/story/lib/errors"
+)
+
+// cgoBackend recovers public keys via go-ethereum's SigToPub, which calls into
+// the libsecp256k1 CGO bindings and gives the best throughput where CGO is
+// available.
+type cgoBackend struct{}
+
+var selectedBackend backend = cgoBackend{}
+
+func (cgoBackend) sign(privkey *secp256k1.PrivateKey, hash [32]byte) ([65]byte, error) {
+	return signCompact(privkey, hash)
+}
+
+func (cgoBackend) recover(hash [32]byte, sig [65]byte) (*secp256k1.PublicKey, error) {
+	// Adjust V from Ethereum 27/28 to secp256k1 0/1.
+	const vIdx = 64
+	if v := sig[vIdx]; v != 27 && v != 28 {
+		return nil, errInvalidRecoveryID
+	}
+	sig[vIdx] -= 27
+
+	pubkey, err := ethcrypto.SigToPub(hash[:], sig[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "recover public key")
+	}
+
+	return secp256k1.ParsePubKey(ethcrypto.CompressPubkey(pubkey))
+}
+
+func (cgoBackend) address(pubkey *secp256k1.PublicKey) common.Address {
+	return ethcrypto.PubkeyToAddress(*pubkey.ToECDSA())
+}
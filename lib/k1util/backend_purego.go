@@ -0,0 +1,53 @@
+//go:build purego
+
+package k1util
+
+import (
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/piplabs/story/lib/errors"
+)
+
+// pureGoBackend performs recovery entirely with dcrec/secp256k1, so the hot
+// signing/verification path has no go-ethereum / CGO libsecp256k1 dependency.
+type pureGoBackend struct{}
+
+var selectedBackend backend = pureGoBackend{}
+
+func (pureGoBackend) sign(privkey *secp256k1.PrivateKey, hash [32]byte) ([65]byte, error) {
+	return signCompact(privkey, hash)
+}
+
+func (pureGoBackend) recover(hash [32]byte, sig [65]byte) (*secp256k1.PublicKey, error) {
+	// RecoverCompact's leading recovery code is 27 + recovery id, the same as
+	// Ethereum's V 27/28, so V is passed through unchanged.
+	const vIdx = 64
+	if v := sig[vIdx]; v != 27 && v != 28 {
+		return nil, errInvalidRecoveryID
+	}
+
+	compact := append([]byte{sig[vIdx]}, sig[:64]...)
+
+	pubkey, _, err := ecdsa.RecoverCompact(compact, hash[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "recover public key")
+	}
+
+	return pubkey, nil
+}
+
+// address hashes pubkey's uncompressed point with Keccak256 directly, rather
+// than via ethcrypto.PubkeyToAddress, so this backend's hot path never calls
+// into go-ethereum/crypto.
+func (pureGoBackend) address(pubkey *secp256k1.PublicKey) common.Address {
+	uncompressed := pubkey.SerializeUncompressed()
+
+	h := sha3.NewLegacyKeccak256()
+	h.Write(uncompressed[1:])
+	sum := h.Sum(nil)
+
+	return common.BytesToAddress(sum[12:])
+}
@@ -0,0 +1,75 @@
+package k1util
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// VerifyItem is a single (address, hash, signature) tuple verified by
+// VerifyBatch.
+type VerifyItem struct {
+	Address common.Address
+	Hash    [32]byte
+	Sig     [65]byte
+}
+
+// VerifyBatch verifies many signatures concurrently, returning one bool per
+// item in items, in the same order. It exists for hot paths — attestation and
+// vote extension verification — that otherwise call Verify one-by-one and
+// dominate block-processing CPU on high-validator-count networks.
+//
+// Each item is verified independently via Verify, fanned out across a worker
+// pool sized to GOMAXPROCS, so VerifyBatch always agrees with calling Verify
+// on every item one at a time. An earlier version tried to skip recovery for
+// repeat addresses by checking only R‖S against a cached public key; that
+// ignored the signature's V byte, so a bad V could pass or fail depending on
+// goroutine scheduling. Recovery is cheap enough per item that there's no
+// sound way to short-circuit it without also recomputing V, so this just
+// parallelizes Verify itself.
+func VerifyBatch(items []VerifyItem) ([]bool, error) {
+	results := make([]bool, len(items))
+	if len(items) == 0 {
+		return results, nil
+	}
+
+	workers := runtime.GOMAXPROCS(0)
+	if workers > len(items) {
+		workers = len(items)
+	}
+
+	var (
+		next     atomic.Int64
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+
+			for {
+				i := next.Add(1) - 1
+				if int(i) >= len(items) {
+					return
+				}
+
+				ok, err := Verify(items[i].Address, items[i].Hash, items[i].Sig)
+				if err != nil {
+					errOnce.Do(func() { firstErr = err })
+
+					continue
+				}
+
+				results[i] = ok
+			}
+		}()
+	}
+	wg.Wait()
+
+	return results, firstErr
+}
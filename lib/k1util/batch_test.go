@@ -0,0 +1,99 @@
+package k1util_test
+
+import (
+	"testing"
+
+	k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/piplabs/story/lib/k1util"
+)
+
+func TestVerifyBatch(t *testing.T) {
+	t.Parallel()
+
+	keyA := k1.GenPrivKey()
+	keyB := k1.GenPrivKey()
+
+	addrA, err := k1util.PubKeyToAddress(keyA.PubKey())
+	require.NoError(t, err)
+	addrB, err := k1util.PubKeyToAddress(keyB.PubKey())
+	require.NoError(t, err)
+
+	hash1 := [32]byte{1}
+	hash2 := [32]byte{2}
+	hash3 := [32]byte{3}
+
+	sigA1, err := k1util.Sign(keyA, hash1)
+	require.NoError(t, err)
+	sigA2, err := k1util.Sign(keyA, hash2) // same address as sigA1, second hash.
+	require.NoError(t, err)
+	sigB1, err := k1util.Sign(keyB, hash3)
+	require.NoError(t, err)
+
+	items := []k1util.VerifyItem{
+		{Address: addrA, Hash: hash1, Sig: sigA1},
+		{Address: addrA, Hash: hash2, Sig: sigA2},
+		{Address: addrB, Hash: hash3, Sig: sigB1},
+		{Address: addrB, Hash: hash1, Sig: sigA1}, // wrong signer for this address.
+	}
+
+	results, err := k1util.VerifyBatch(items)
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, true, true, false}, results)
+}
+
+// TestVerifyBatch_WrongVAgreesWithVerify guards against a prior bug where a
+// cached fast-path checked only R‖S against a known public key and ignored
+// V, so a repeat address with a flipped V byte could return true even though
+// Verify itself would reject it.
+func TestVerifyBatch_WrongVAgreesWithVerify(t *testing.T) {
+	t.Parallel()
+
+	key := k1.GenPrivKey()
+	address, err := k1util.PubKeyToAddress(key.PubKey())
+	require.NoError(t, err)
+
+	hash := [32]byte{5}
+
+	sig, err := k1util.Sign(key, hash)
+	require.NoError(t, err)
+
+	badV := sig
+	badV[64] = 27 + 28 - sig[64] // flip 27<->28, still a well-formed V.
+
+	want, err := k1util.Verify(address, hash, badV)
+	require.NoError(t, err)
+
+	items := []k1util.VerifyItem{
+		{Address: address, Hash: hash, Sig: sig},  // warms any per-address state.
+		{Address: address, Hash: hash, Sig: badV}, // must match k1util.Verify, not just R‖S.
+	}
+
+	results, err := k1util.VerifyBatch(items)
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, want}, results)
+}
+
+func TestVerifyBatch_Empty(t *testing.T) {
+	t.Parallel()
+
+	results, err := k1util.VerifyBatch(nil)
+	require.NoError(t, err)
+	require.Empty(t, results)
+}
+
+func TestVerifyBatch_InvalidRecoveryID(t *testing.T) {
+	t.Parallel()
+
+	key := k1.GenPrivKey()
+	address, err := k1util.PubKeyToAddress(key.PubKey())
+	require.NoError(t, err)
+
+	sig, err := k1util.Sign(key, [32]byte{1})
+	require.NoError(t, err)
+	sig[64] = 0 // invalid V.
+
+	_, err = k1util.VerifyBatch([]k1util.VerifyItem{{Address: address, Hash: [32]byte{1}, Sig: sig}})
+	require.Error(t, err)
+}
@@ -0,0 +1,90 @@
+package k1util
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/piplabs/story/lib/errors"
+)
+
+// SignPersonal signs msg using the EIP-191 "\x19Ethereum Signed Message:\n<len>"
+// prefix, the same scheme wallets use for personal_sign. The corresponding
+// on-chain ecrecover must hash msg the same way before recovering the signer.
+func SignPersonal(key crypto.PrivKey, msg []byte) ([65]byte, error) {
+	return Sign(key, personalHash(msg))
+}
+
+// SignPersonalWithSigner is SignPersonal for callers that hold a Signer
+// rather than a raw crypto.PrivKey, e.g. because the key lives in a remote
+// KMS.
+func SignPersonalWithSigner(ctx context.Context, signer Signer, msg []byte) ([65]byte, error) {
+	return signer.SignHash(ctx, personalHash(msg))
+}
+
+// VerifyPersonal returns whether sig is a valid EIP-191 personal-message
+// signature over msg for the given Ethereum address.
+func VerifyPersonal(address common.Address, msg []byte, sig [65]byte) (bool, error) {
+	return Verify(address, personalHash(msg), sig)
+}
+
+// personalHash returns the EIP-191 digest of msg.
+func personalHash(msg []byte) [32]byte {
+	prefix := fmt.Sprintf("\x19Ethereum Signed Message:\n%d", len(msg))
+
+	return [32]byte(ethcrypto.Keccak256Hash([]byte(prefix), msg))
+}
+
+// SignTypedData signs typedData using its EIP-712 domain-separator/message
+// hash, the same digest wallets produce for eth_signTypedData_v4.
+func SignTypedData(key crypto.PrivKey, typedData apitypes.TypedData) ([65]byte, error) {
+	hash, err := typedDataHash(typedData)
+	if err != nil {
+		return [65]byte{}, err
+	}
+
+	return Sign(key, hash)
+}
+
+// SignTypedDataWithSigner is SignTypedData for callers that hold a Signer
+// rather than a raw crypto.PrivKey, e.g. because the key lives in a remote
+// KMS.
+func SignTypedDataWithSigner(ctx context.Context, signer Signer, typedData apitypes.TypedData) ([65]byte, error) {
+	hash, err := typedDataHash(typedData)
+	if err != nil {
+		return [65]byte{}, err
+	}
+
+	return signer.SignHash(ctx, hash)
+}
+
+// VerifyTypedData returns whether sig is a valid EIP-712 signature over
+// typedData for the given Ethereum address.
+func VerifyTypedData(address common.Address, typedData apitypes.TypedData, sig [65]byte) (bool, error) {
+	hash, err := typedDataHash(typedData)
+	if err != nil {
+		return false, err
+	}
+
+	return Verify(address, hash, sig)
+}
+
+// typedDataHash returns the EIP-712 digest of typedData: keccak256("\x19\x01"
+// || domainSeparator || hashStruct(message)).
+func typedDataHash(typedData apitypes.TypedData) ([32]byte, error) {
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "hash domain separator")
+	}
+
+	messageHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "hash typed data message")
+	}
+
+	return [32]byte(ethcrypto.Keccak256Hash([]byte{0x19, 0x01}, domainSeparator, messageHash)), nil
+}
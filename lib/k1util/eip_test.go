@@ -0,0 +1,131 @@
+package k1util_test
+
+import (
+	"context"
+	"testing"
+
+	k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/piplabs/story/lib/k1util"
+)
+
+func TestSignPersonal_VerifyPersonal(t *testing.T) {
+	t.Parallel()
+
+	key := k1.GenPrivKey()
+	address, err := k1util.PubKeyToAddress(key.PubKey())
+	require.NoError(t, err)
+
+	msg := []byte("hello story")
+
+	sig, err := k1util.SignPersonal(key, msg)
+	require.NoError(t, err)
+
+	ok, err := k1util.VerifyPersonal(address, msg, sig)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+// TestSignPersonal_MatchesEIP191Reference checks personalHash against
+// go-ethereum's own accounts.TextHash, an independent implementation of the
+// same EIP-191 prefix scheme.
+func TestSignPersonal_MatchesEIP191Reference(t *testing.T) {
+	t.Parallel()
+
+	key := k1.GenPrivKey()
+	address, err := k1util.PubKeyToAddress(key.PubKey())
+	require.NoError(t, err)
+
+	msg := []byte("hello story")
+
+	sig, err := k1util.SignPersonal(key, msg)
+	require.NoError(t, err)
+
+	var hash [32]byte
+	copy(hash[:], accounts.TextHash(msg))
+
+	ok, err := k1util.Verify(address, hash, sig)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestSignPersonalWithSigner_MatchesSignPersonal(t *testing.T) {
+	t.Parallel()
+
+	key := k1.GenPrivKey()
+	signer, err := k1util.NewLocalSigner(key)
+	require.NoError(t, err)
+
+	msg := []byte("hello story")
+
+	want, err := k1util.SignPersonal(key, msg)
+	require.NoError(t, err)
+
+	got, err := k1util.SignPersonalWithSigner(context.Background(), signer, msg)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestSignTypedData_VerifyTypedData(t *testing.T) {
+	t.Parallel()
+
+	key := k1.GenPrivKey()
+	address, err := k1util.PubKeyToAddress(key.PubKey())
+	require.NoError(t, err)
+
+	typedData := testTypedData()
+
+	sig, err := k1util.SignTypedData(key, typedData)
+	require.NoError(t, err)
+
+	ok, err := k1util.VerifyTypedData(address, typedData, sig)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestSignTypedDataWithSigner_MatchesSignTypedData(t *testing.T) {
+	t.Parallel()
+
+	key := k1.GenPrivKey()
+	signer, err := k1util.NewLocalSigner(key)
+	require.NoError(t, err)
+
+	typedData := testTypedData()
+
+	want, err := k1util.SignTypedData(key, typedData)
+	require.NoError(t, err)
+
+	got, err := k1util.SignTypedDataWithSigner(context.Background(), signer, typedData)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func testTypedData() apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+			},
+			"Mail": {
+				{Name: "from", Type: "string"},
+				{Name: "contents", Type: "string"},
+			},
+		},
+		PrimaryType: "Mail",
+		Domain: apitypes.TypedDataDomain{
+			Name:    "story",
+			Version: "1",
+			ChainId: math.NewHexOrDecimal256(1),
+		},
+		Message: apitypes.TypedDataMessage{
+			"from":     "alice",
+			"contents": "hello",
+		},
+	}
+}
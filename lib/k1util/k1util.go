@@ -17,7 +17,6 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 
-	"github.com/piplabs/story/lib/cast"
 	"github.com/piplabs/story/lib/errors"
 )
 
@@ -39,10 +38,7 @@ func Sign(key crypto.PrivKey, input [32]byte) ([65]byte, error) {
 		return [65]byte{}, errors.New("invalid private key length")
 	}
 
-	sig := ecdsa.SignCompact(secp256k1.PrivKeyFromBytes(bz), input[:], false)
-
-	// Convert signature from "compact" into "Ethereum R S V" format.
-	return cast.Array65(append(sig[1:], sig[0]))
+	return selectedBackend.sign(secp256k1.PrivKeyFromBytes(bz), input)
 }
 
 // Verify returns whether the 65 byte signature is valid for the provided hash
@@ -50,21 +46,34 @@ func Sign(key crypto.PrivKey, input [32]byte) ([65]byte, error) {
 //
 // Note the signature MUST be 65 bytes in the Ethereum [R || S || V] format.
 func Verify(address common.Address, hash [32]byte, sig [65]byte) (bool, error) {
-	// Adjust V from Ethereum 27/28 to secp256k1 0/1
-	const vIdx = 64
-	if v := sig[vIdx]; v != 27 && v != 28 {
-		return false, errors.New("invalid recovery id (V) format, must be 27 or 28")
+	pubkey, err := selectedBackend.recover(hash, sig)
+	if err != nil {
+		return false, err
+	}
+
+	return selectedBackend.address(pubkey) == address, nil
+}
+
+// VerifySignature returns whether the 64 byte (R || S) signature is valid for the
+// provided hash and public key. Unlike Verify, it does not recover and compare an
+// address, which is cheaper when the caller already knows who should have signed.
+func VerifySignature(pubkey crypto.PubKey, hash [32]byte, sig [64]byte) (bool, error) {
+	pubkeyBytes := pubkey.Bytes()
+	if len(pubkeyBytes) != pubkeyCompressedLen {
+		return false, errors.New("invalid pubkey length", "length", len(pubkeyBytes))
 	}
-	sig[vIdx] -= 27
 
-	pubkey, err := ethcrypto.SigToPub(hash[:], sig[:])
+	parsedPubkey, err := secp256k1.ParsePubKey(pubkeyBytes)
 	if err != nil {
-		return false, errors.Wrap(err, "recover public key")
+		return false, errors.Wrap(err, "parse pubkey")
 	}
 
-	actual := ethcrypto.PubkeyToAddress(*pubkey)
+	r := new(secp256k1.ModNScalar)
+	r.SetByteSlice(sig[:32])
+	s := new(secp256k1.ModNScalar)
+	s.SetByteSlice(sig[32:])
 
-	return actual == address, nil
+	return ecdsa.NewSignature(r, s).Verify(hash[:], parsedPubkey), nil
 }
 
 // PubKeyToAddress returns the Ethereum address for the given k1 public key.
@@ -0,0 +1,91 @@
+package k1util_test
+
+import (
+	"testing"
+
+	k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/piplabs/story/lib/k1util"
+)
+
+func TestSignVerify(t *testing.T) {
+	t.Parallel()
+
+	key := k1.GenPrivKey()
+	hash := [32]byte{1, 2, 3}
+
+	sig, err := k1util.Sign(key, hash)
+	require.NoError(t, err)
+
+	address, err := k1util.PubKeyToAddress(key.PubKey())
+	require.NoError(t, err)
+
+	ok, err := k1util.Verify(address, hash, sig)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	var otherHash [32]byte
+	otherHash[0] = 0xff
+
+	ok, err = k1util.Verify(address, otherHash, sig)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestVerifySignature(t *testing.T) {
+	t.Parallel()
+
+	key := k1.GenPrivKey()
+	hash := [32]byte{4, 5, 6}
+
+	sig, err := k1util.Sign(key, hash)
+	require.NoError(t, err)
+
+	var sig64 [64]byte
+	copy(sig64[:], sig[:64])
+
+	ok, err := k1util.VerifySignature(key.PubKey(), hash, sig64)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+// BenchmarkSign and BenchmarkVerify exercise whichever backend is active for
+// the current build tags (the default CGO-accelerated backend, or the
+// pure-Go one under `-tags purego`). Compare them by running:
+//
+//	go test ./lib/k1util/... -run '^$' -bench .
+//	go test ./lib/k1util/... -run '^$' -bench . -tags purego
+func BenchmarkSign(b *testing.B) {
+	key := k1.GenPrivKey()
+	hash := [32]byte{1, 2, 3}
+
+	const n = 10_000
+
+	for range b.N {
+		for range n {
+			_, err := k1util.Sign(key, hash)
+			require.NoError(b, err)
+		}
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	key := k1.GenPrivKey()
+	hash := [32]byte{1, 2, 3}
+
+	sig, err := k1util.Sign(key, hash)
+	require.NoError(b, err)
+
+	address, err := k1util.PubKeyToAddress(key.PubKey())
+	require.NoError(b, err)
+
+	const n = 10_000
+
+	for range b.N {
+		for range n {
+			_, err := k1util.Verify(address, hash, sig)
+			require.NoError(b, err)
+		}
+	}
+}
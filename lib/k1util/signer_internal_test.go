@@ -0,0 +1,38 @@
+package k1util
+
+import (
+	"encoding/asn1"
+	"math/big"
+	"testing"
+
+	k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDerSigToRSV round-trips a signature through derSigToRSV: Sign produces
+// the RSV signature directly, and this rebuilds the same (R, S) as a DER
+// blob the way Cloud KMS returns it, then checks derSigToRSV recovers the
+// identical 65 byte signature.
+func TestDerSigToRSV(t *testing.T) {
+	t.Parallel()
+
+	key := k1.GenPrivKey()
+	hash := [32]byte{1, 2, 3}
+
+	sig, err := Sign(key, hash)
+	require.NoError(t, err)
+
+	want, err := ethcrypto.DecompressPubkey(key.PubKey().Bytes())
+	require.NoError(t, err)
+
+	der, err := asn1.Marshal(struct{ R, S *big.Int }{
+		R: new(big.Int).SetBytes(sig[:32]),
+		S: new(big.Int).SetBytes(sig[32:64]),
+	})
+	require.NoError(t, err)
+
+	rsv, err := derSigToRSV(der, hash, want)
+	require.NoError(t, err)
+	require.Equal(t, sig, rsv)
+}
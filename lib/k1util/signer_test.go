@@ -0,0 +1,34 @@
+package k1util_test
+
+import (
+	"context"
+	"testing"
+
+	k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/piplabs/story/lib/k1util"
+)
+
+func TestLocalSigner(t *testing.T) {
+	t.Parallel()
+
+	key := k1.GenPrivKey()
+
+	signer, err := k1util.NewLocalSigner(key)
+	require.NoError(t, err)
+
+	wantAddress, err := k1util.PubKeyToAddress(key.PubKey())
+	require.NoError(t, err)
+	require.Equal(t, wantAddress, signer.Address())
+	require.Equal(t, key.PubKey(), signer.PublicKey())
+
+	hash := [32]byte{9, 9, 9}
+
+	sig, err := signer.SignHash(context.Background(), hash)
+	require.NoError(t, err)
+
+	ok, err := k1util.Verify(signer.Address(), hash, sig)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
@@ -0,0 +1,86 @@
+package threshold
+
+import (
+	"context"
+
+	"github.com/piplabs/story/lib/errors"
+)
+
+// CeremonyState is the phase of a Ceremony.
+type CeremonyState int
+
+const (
+	CeremonyPending CeremonyState = iota
+	CeremonyKeyed
+	CeremonyFinalized
+)
+
+// ShareStore persists and retrieves a party's long-term key share, so a
+// Ceremony can distribute shares to HSMs or remote signers instead of
+// leaving them only in process memory.
+type ShareStore interface {
+	SaveShare(ctx context.Context, share Share) error
+	LoadShare(ctx context.Context, index uint32) (Share, error)
+}
+
+// Ceremony drives a single (t, n) key generation through its rounds,
+// persisting each resulting share via a ShareStore.
+//
+// Round1 generates and splits the joint key; Round2 persists the shares.
+// Because Keygen uses a trusted dealer rather than a multi-party protocol,
+// these rounds run in a single process — see the package doc for the
+// tradeoff this makes against a non-custodial scheme.
+type Ceremony struct {
+	t, n   int
+	store  ShareStore
+	state  CeremonyState
+	result KeyGenResult
+}
+
+// NewCeremony returns a Ceremony that will generate a (t, n) threshold key
+// and persist its shares via store.
+func NewCeremony(t, n int, store ShareStore) *Ceremony {
+	return &Ceremony{t: t, n: n, store: store}
+}
+
+// Round1 generates the joint key and splits it into shares.
+func (c *Ceremony) Round1() error {
+	if c.state != CeremonyPending {
+		return errors.New("ceremony already started")
+	}
+
+	result, err := Keygen(c.t, c.n)
+	if err != nil {
+		return err
+	}
+
+	c.result = result
+	c.state = CeremonyKeyed
+
+	return nil
+}
+
+// Round2 persists each share via the configured ShareStore, e.g. one per
+// remote signer or HSM-backed operator.
+func (c *Ceremony) Round2(ctx context.Context) error {
+	if c.state != CeremonyKeyed {
+		return errors.New("round1 must complete before round2")
+	}
+
+	for _, share := range c.result.Shares {
+		if err := c.store.SaveShare(ctx, share); err != nil {
+			return errors.Wrap(err, "save share", "index", share.Index)
+		}
+	}
+
+	c.state = CeremonyFinalized
+
+	return nil
+}
+
+// Result returns the ceremony's KeyGenResult: the joint public key and
+// address, and the raw shares themselves. It is only populated once Round1
+// has completed.
+func (c *Ceremony) Result() KeyGenResult {
+	return c.result
+}
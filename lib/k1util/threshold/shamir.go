@@ -0,0 +1,104 @@
+package threshold
+
+import (
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	"github.com/piplabs/story/lib/errors"
+)
+
+// share is a single point (index, f(index)) on a degree (t-1) polynomial over
+// the secp256k1 scalar field, as produced by splitScalar.
+type share struct {
+	index uint32
+	value secp256k1.ModNScalar
+}
+
+// splitScalar splits secret into n Shamir shares such that any t of them
+// reconstruct secret via combineScalars, and fewer than t reveal nothing
+// about it.
+func splitScalar(secret secp256k1.ModNScalar, t, n int) ([]share, error) {
+	if t < 1 || n < t {
+		return nil, errors.New("invalid threshold parameters", "t", t, "n", n)
+	}
+
+	coeffs := make([]secp256k1.ModNScalar, t)
+	coeffs[0] = secret
+	for i := 1; i < t; i++ {
+		k, err := secp256k1.GeneratePrivateKey()
+		if err != nil {
+			return nil, errors.Wrap(err, "generate polynomial coefficient")
+		}
+
+		coeffs[i] = k.Key
+	}
+
+	shares := make([]share, n)
+	for i := range n {
+		x := new(secp256k1.ModNScalar).SetInt(uint32(i + 1))
+
+		var y secp256k1.ModNScalar
+		for j := t - 1; j >= 0; j-- {
+			y.Mul(x)
+			y.Add(&coeffs[j])
+		}
+
+		shares[i] = share{index: uint32(i + 1), value: y}
+	}
+
+	return shares, nil
+}
+
+// combineScalars reconstructs the polynomial's constant term (the shared
+// secret) from t or more shares, via Lagrange interpolation at x = 0.
+func combineScalars(shares []share) (secp256k1.ModNScalar, error) {
+	if len(shares) == 0 {
+		return secp256k1.ModNScalar{}, errors.New("no shares to combine")
+	}
+
+	var secret secp256k1.ModNScalar
+	for i := range shares {
+		lambda := lagrangeAtZero(shares, i)
+
+		term := shares[i].value
+		term.Mul(&lambda)
+
+		secret.Add(&term)
+	}
+
+	return secret, nil
+}
+
+// lagrangeAtZero returns the i'th Lagrange basis polynomial, evaluated at
+// x = 0, for the x-coordinates of shares.
+func lagrangeAtZero(shares []share, i int) secp256k1.ModNScalar {
+	var num, den secp256k1.ModNScalar
+	num.SetInt(1)
+	den.SetInt(1)
+
+	xi := new(secp256k1.ModNScalar).SetInt(shares[i].index)
+
+	for j := range shares {
+		if j == i {
+			continue
+		}
+
+		xj := new(secp256k1.ModNScalar).SetInt(shares[j].index)
+
+		// num *= x_j; den *= (x_j - x_i), both evaluating the basis
+		// polynomial at x = 0.
+		num.Mul(xj)
+
+		negXi := *xi
+		negXi.Negate()
+
+		diff := *xj
+		diff.Add(&negXi)
+
+		den.Mul(&diff)
+	}
+
+	den.InverseNonConst()
+	num.Mul(&den)
+
+	return num
+}
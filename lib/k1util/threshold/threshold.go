@@ -0,0 +1,191 @@
+// Package threshold implements (t, n) Shamir key-splitting custody for
+// k1util signing: a trusted dealer splits a secp256k1 private key into n
+// shares, any t of which reconstruct a standard 65 byte [R || S || V]
+// signature over the joint Ethereum address — the same format produced by
+// k1util.Sign and accepted by k1util.Verify.
+//
+// Despite the Partial/PartialSign naming, this is NOT a non-custodial MPC
+// protocol such as FROST or GG20, and a Partial is not a cryptographic
+// partial signature: its Value is a copy of the raw Shamir share, so
+// transmitting one between parties transmits key material and needs the
+// same protections as the joint private key. Combine reconstructs that joint
+// private key in the combiner's process in order to sign, then discards it.
+// A true non-custodial scheme never reconstructs the key anywhere and
+// requires homomorphic encryption, zero-knowledge proofs and multiple
+// network rounds between parties; this package is meant for operators who
+// knowingly accept custodial risk in exchange for splitting a key across
+// HSMs or remote signers (see Ceremony and ShareStore) without that
+// operational cost. Upgrading to a non-custodial protocol is a natural
+// follow-up once this has mileage, but is a different package, not a drop-in
+// replacement for this one.
+package threshold
+
+import (
+	"crypto/rand"
+
+	"github.com/cometbft/cometbft/crypto"
+	k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/piplabs/story/lib/errors"
+	"github.com/piplabs/story/lib/k1util"
+)
+
+// Share is one party's long-term key share from a single Keygen run. Its
+// Value is the raw Shamir share, not a cryptographic partial signature, so it
+// needs the same confidentiality as the joint private key itself; KeygenID
+// ties it to the run it was produced by, so Combine can reject shares pulled
+// in from a different Keygen.
+type Share struct {
+	Index     uint32
+	Threshold uint32
+	KeygenID  [16]byte
+	Value     [32]byte
+}
+
+// Partial is one party's contribution, over a specific hash, toward
+// reconstructing a signature. Despite the name, this is not a partial
+// signature in the FROST/GG20 sense — Value is the share itself, copied out
+// of the Share that produced it — so a Partial must be protected like key
+// material in transit and is only safe to combine with other Partials from
+// the same hash and the same Keygen run (KeygenID).
+type Partial struct {
+	Index     uint32
+	Threshold uint32
+	KeygenID  [16]byte
+	Value     [32]byte
+	Hash      [32]byte
+}
+
+// KeyGenResult is the output of Keygen: the shares to distribute to each
+// party, and the joint public key / Ethereum address they collectively sign
+// for.
+type KeyGenResult struct {
+	Shares  []Share
+	PubKey  crypto.PubKey
+	Address common.Address
+}
+
+// Keygen generates a joint secp256k1 key and splits it into n shares, t of
+// which are required to reconstruct a signature. The joint private key is
+// zeroed before this call returns and is never returned or persisted;
+// Combine later reconstructs it transiently in order to sign.
+func Keygen(t, n int) (KeyGenResult, error) {
+	priv, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		return KeyGenResult{}, errors.Wrap(err, "generate joint key")
+	}
+	defer priv.Zero()
+
+	var keygenID [16]byte
+	if _, err := rand.Read(keygenID[:]); err != nil {
+		return KeyGenResult{}, errors.Wrap(err, "generate keygen id")
+	}
+
+	raw, err := splitScalar(priv.Key, t, n)
+	if err != nil {
+		return KeyGenResult{}, err
+	}
+
+	shares := make([]Share, len(raw))
+	for i, s := range raw {
+		shares[i] = Share{Index: s.index, Threshold: uint32(t), KeygenID: keygenID, Value: *s.value.Bytes()}
+	}
+
+	pubkey := k1.PubKey(priv.PubKey().SerializeCompressed())
+
+	address, err := k1util.PubKeyToAddress(pubkey)
+	if err != nil {
+		return KeyGenResult{}, errors.Wrap(err, "derive joint address")
+	}
+
+	return KeyGenResult{Shares: shares, PubKey: pubkey, Address: address}, nil
+}
+
+// PartialSign binds share to hash, producing share's contribution toward a
+// signature over hash. In this trusted-dealer scheme that binding, rather
+// than any local computation, is what PartialSign does — the returned
+// Partial's Value is share's Value unchanged — so it exists so the surface
+// doesn't need to change if this package later gains a non-custodial
+// backend that computes a real partial signature instead of copying the
+// share out.
+func PartialSign(share Share, hash [32]byte) (Partial, error) {
+	var value secp256k1.ModNScalar
+	if overflow := value.SetBytes(&share.Value); overflow != 0 {
+		return Partial{}, errors.New("invalid share value")
+	}
+
+	return Partial{
+		Index:     share.Index,
+		Threshold: share.Threshold,
+		KeygenID:  share.KeygenID,
+		Value:     share.Value,
+		Hash:      hash,
+	}, nil
+}
+
+// Combine reconstructs the joint private key from Partials produced for the
+// same hash and the same Keygen run, signs hash with it, and discards the
+// reconstructed key before returning. The result is a standard 65 byte
+// [R || S || V] signature, indistinguishable from one produced by
+// k1util.Sign and verifiable with k1util.Verify against the joint address.
+//
+// Combine rejects fewer partials than the threshold recorded in them: fewer
+// than threshold shares interpolate to a different point on the polynomial,
+// not an error from the underlying math, so without this check Combine would
+// silently produce a signature that recovers to the wrong address instead of
+// failing. It also rejects partials whose KeygenID differs: two Keygen runs
+// sharing the same t otherwise interpolate without error, but to an
+// unrelated secret, which fails the same way — a signature that silently
+// recovers to the wrong address.
+func Combine(partials []Partial) ([65]byte, error) {
+	if len(partials) == 0 {
+		return [65]byte{}, errors.New("no partials to combine")
+	}
+
+	hash := partials[0].Hash
+	threshold := partials[0].Threshold
+	keygenID := partials[0].KeygenID
+
+	seenIndex := make(map[uint32]bool, len(partials))
+	shares := make([]share, len(partials))
+	for i, p := range partials {
+		if p.Hash != hash {
+			return [65]byte{}, errors.New("partials are for different signing sessions")
+		}
+		if p.KeygenID != keygenID {
+			return [65]byte{}, errors.New("partials are from different keygen runs")
+		}
+		if p.Threshold != threshold {
+			return [65]byte{}, errors.New("partials have inconsistent thresholds")
+		}
+		if seenIndex[p.Index] {
+			return [65]byte{}, errors.New("duplicate partial index", "index", p.Index)
+		}
+		seenIndex[p.Index] = true
+
+		var value secp256k1.ModNScalar
+		if overflow := value.SetBytes(&p.Value); overflow != 0 {
+			return [65]byte{}, errors.New("invalid partial value")
+		}
+
+		shares[i] = share{index: p.Index, value: value}
+	}
+
+	if uint32(len(shares)) < threshold {
+		return [65]byte{}, errors.New("insufficient partials to meet threshold",
+			"have", len(shares), "want", threshold)
+	}
+
+	secret, err := combineScalars(shares)
+	if err != nil {
+		return [65]byte{}, err
+	}
+	defer secret.Zero()
+
+	privBytes := secret.Bytes()
+	defer func() { *privBytes = [32]byte{} }()
+
+	return k1util.Sign(k1.PrivKey(privBytes[:]), hash)
+}
@@ -0,0 +1,128 @@
+package threshold_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/piplabs/story/lib/k1util/threshold"
+)
+
+func TestThresholdSign_EcrecoverInterop(t *testing.T) {
+	t.Parallel()
+
+	const (
+		tThreshold = 3
+		n          = 5
+	)
+
+	result, err := threshold.Keygen(tThreshold, n)
+	require.NoError(t, err)
+
+	hash := [32]byte{1, 2, 3, 4, 5}
+
+	partials := make([]threshold.Partial, 0, tThreshold)
+	for _, s := range result.Shares[:tThreshold] {
+		p, err := threshold.PartialSign(s, hash)
+		require.NoError(t, err)
+
+		partials = append(partials, p)
+	}
+
+	sig, err := threshold.Combine(partials)
+	require.NoError(t, err)
+
+	pubkey, err := crypto.SigToPub(hash[:], append(append([]byte{}, sig[:64]...), sig[64]-27))
+	require.NoError(t, err)
+
+	require.Equal(t, result.Address, crypto.PubkeyToAddress(*pubkey))
+}
+
+func TestThresholdSign_DifferentShareSubsetsAgree(t *testing.T) {
+	t.Parallel()
+
+	const (
+		tThreshold = 2
+		n          = 4
+	)
+
+	result, err := threshold.Keygen(tThreshold, n)
+	require.NoError(t, err)
+
+	hash := [32]byte{9, 9, 9}
+
+	sign := func(shares []threshold.Share) [65]byte {
+		partials := make([]threshold.Partial, 0, len(shares))
+		for _, s := range shares {
+			p, err := threshold.PartialSign(s, hash)
+			require.NoError(t, err)
+
+			partials = append(partials, p)
+		}
+
+		sig, err := threshold.Combine(partials)
+		require.NoError(t, err)
+
+		return sig
+	}
+
+	sigA := sign(result.Shares[0:2])
+	sigB := sign(result.Shares[2:4])
+
+	require.Equal(t, sigA, sigB)
+}
+
+func TestCombine_RejectsBelowThreshold(t *testing.T) {
+	t.Parallel()
+
+	result, err := threshold.Keygen(3, 5)
+	require.NoError(t, err)
+
+	hash := [32]byte{7}
+
+	partials := make([]threshold.Partial, 0, 2)
+	for _, s := range result.Shares[:2] {
+		p, err := threshold.PartialSign(s, hash)
+		require.NoError(t, err)
+
+		partials = append(partials, p)
+	}
+
+	_, err = threshold.Combine(partials)
+	require.Error(t, err)
+}
+
+func TestCombine_RejectsDuplicateIndex(t *testing.T) {
+	t.Parallel()
+
+	result, err := threshold.Keygen(2, 3)
+	require.NoError(t, err)
+
+	hash := [32]byte{3}
+
+	p, err := threshold.PartialSign(result.Shares[0], hash)
+	require.NoError(t, err)
+
+	_, err = threshold.Combine([]threshold.Partial{p, p})
+	require.Error(t, err)
+}
+
+func TestCombine_RejectsMixedKeygenRuns(t *testing.T) {
+	t.Parallel()
+
+	resultA, err := threshold.Keygen(2, 3)
+	require.NoError(t, err)
+	resultB, err := threshold.Keygen(2, 3)
+	require.NoError(t, err)
+
+	hash := [32]byte{4}
+
+	pA, err := threshold.PartialSign(resultA.Shares[0], hash)
+	require.NoError(t, err)
+	pB, err := threshold.PartialSign(resultB.Shares[1], hash)
+	require.NoError(t, err)
+
+	_, err = threshold.Combine([]threshold.Partial{pA, pB})
+	require.Error(t, err)
+}